@@ -0,0 +1,24 @@
+// Command knative-plugin is the vcluster-sdk plugin entrypoint: it loads the
+// revision syncer's config from the plugin manifest and registers it with
+// the vcluster-sdk plugin host.
+package main
+
+import (
+	"github.com/loft-sh/vcluster-sdk/plugin"
+	"k8s.io/klog"
+
+	"github.com/jbarfield/vcluster-plugins/knative-plugin/pkg/syncers/revision"
+)
+
+func main() {
+	ctx := plugin.MustInit()
+
+	config, err := revision.LoadConfigFromEnv()
+	if err != nil {
+		klog.Fatalf("load revision syncer config: %v", err)
+	}
+
+	plugin.MustRegister(revision.New(ctx, config))
+
+	plugin.MustStart()
+}
@@ -0,0 +1,60 @@
+// Command vcluster-debug-revisions execs into a knative-plugin syncer pod
+// and dumps its revisions debug info, mirroring
+// `kubectl exec <syncer-pod> -- curl localhost:<port>/debug/revisions`.
+package main
+
+import (
+	plaincontext "context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/jbarfield/vcluster-plugins/knative-plugin/pkg/debugclient"
+)
+
+func main() {
+	namespace := flag.String("namespace", "", "namespace the syncer pod runs in")
+	pod := flag.String("pod", "", "name of the syncer pod")
+	port := flag.Int("port", 8080, "port the syncer's metrics/debug server listens on")
+	output := flag.String("output", "table", "output format: table or json")
+	flag.Parse()
+
+	if *namespace == "" || *pod == "" {
+		fmt.Fprintln(os.Stderr, "usage: vcluster-debug-revisions --namespace <ns> --pod <syncer-pod> [--port 8080] [--output table|json]")
+		os.Exit(2)
+	}
+
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "load kubeconfig: %v\n", err)
+		os.Exit(1)
+	}
+
+	infos, err := debugclient.FetchRevisions(plaincontext.Background(), cfg, *namespace, *pod, *port)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fetch revisions: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch *output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(infos); err != nil {
+			fmt.Fprintf(os.Stderr, "encode output: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "PHYSICAL\tVIRTUAL\tCONFIGURATION\tMANAGED\tMATCHED OWNER")
+		for _, info := range infos {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\n", info.Physical, info.Virtual, info.Configuration, info.Managed, info.MatchedOwnerKey)
+		}
+		_ = tw.Flush()
+	}
+}
@@ -0,0 +1,57 @@
+// Package debugclient talks to the `vcluster debug revisions` HTTP endpoint
+// registered by the knative-plugin syncer, the same way `kubectl exec
+// <syncer-pod> -- curl localhost:<port>/debug/revisions` would, but as a
+// reusable Go client for the vcluster-debug-revisions CLI.
+package debugclient
+
+import (
+	"bytes"
+	plaincontext "context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/jbarfield/vcluster-plugins/knative-plugin/pkg/syncers/revision"
+)
+
+// FetchRevisions execs into the syncer pod and curls its revisions debug
+// endpoint, returning the decoded per-Revision debug info.
+func FetchRevisions(ctx plaincontext.Context, cfg *rest.Config, namespace, pod string, port int) ([]revision.RevisionDebugInfo, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("build clientset: %w", err)
+	}
+
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod).
+		Namespace(namespace).
+		SubResource("exec")
+	req.VersionedParams(&corev1.PodExecOptions{
+		Command: []string{"curl", "-s", fmt.Sprintf("http://localhost:%d/debug/revisions", port)},
+		Stdout:  true,
+		Stderr:  true,
+	}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return nil, fmt.Errorf("create exec executor: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return nil, fmt.Errorf("exec curl in %s/%s: %w (stderr: %s)", namespace, pod, err, stderr.String())
+	}
+
+	var infos []revision.RevisionDebugInfo
+	if err := json.Unmarshal(stdout.Bytes(), &infos); err != nil {
+		return nil, fmt.Errorf("decode debug response: %w", err)
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,94 @@
+package revision
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// defaultNegativeOwnerCacheSize bounds how many distinct owner UIDs we
+	// remember as "proven unmanaged" at once.
+	defaultNegativeOwnerCacheSize = 1024
+
+	// defaultNegativeOwnerCacheTTL is how long an owner stays in the
+	// negative cache before IsManaged re-walks it. Short enough that a
+	// Configuration/Service later being adopted into a vcluster is picked
+	// up quickly, long enough to absorb bursts of Revision status updates.
+	defaultNegativeOwnerCacheTTL = 30 * time.Second
+)
+
+// negativeOwnerCache remembers, for a bounded time window, owner UIDs whose
+// parent object has already been proven unmanaged by IsManaged. Revisions
+// fire repeated reconcile events (status updates, labels, ...) and without
+// this cache every single one re-walks and re-fetches every owner reference.
+type negativeOwnerCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[types.UID]*list.Element
+	order      *list.List
+}
+
+type negativeOwnerCacheEntry struct {
+	uid       types.UID
+	expiresAt time.Time
+}
+
+func newNegativeOwnerCache(maxEntries int, ttl time.Duration) *negativeOwnerCache {
+	return &negativeOwnerCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    map[types.UID]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+// Known reports whether uid was recently proven unmanaged and hasn't expired.
+func (c *negativeOwnerCache) Known(uid types.UID) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[uid]
+	if !ok {
+		return false
+	}
+
+	entry := el.Value.(*negativeOwnerCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, uid)
+		return false
+	}
+
+	c.order.MoveToFront(el)
+	return true
+}
+
+// Add records uid as unmanaged, evicting the least recently used entry once
+// the cache is full.
+func (c *negativeOwnerCache) Add(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[uid]; ok {
+		el.Value.(*negativeOwnerCacheEntry).expiresAt = time.Now().Add(c.ttl)
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&negativeOwnerCacheEntry{uid: uid, expiresAt: time.Now().Add(c.ttl)})
+	c.entries[uid] = el
+
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		if oldest == nil {
+			break
+		}
+
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*negativeOwnerCacheEntry).uid)
+	}
+}
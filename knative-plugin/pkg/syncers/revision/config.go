@@ -0,0 +1,138 @@
+package revision
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ksvcv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// OrphanedAnnotation marks a virtual Revision whose physical counterpart has
+// disappeared while DeleteVirtualOnMissingPhysical is disabled, instead of
+// the syncer deleting it outright.
+const OrphanedAnnotation = "plugin.vcluster.loft.sh/orphaned"
+
+// Config controls revisionSyncer's sync direction and how it handles objects
+// that have no counterpart on the other side. It's loaded from the plugin's
+// manifest and passed into New.
+type Config struct {
+	// FromHost syncs physical Revisions up into the vcluster. This is the
+	// only direction the syncer supported before ToHost existed, and
+	// defaults to true.
+	FromHost bool `json:"fromHost"`
+
+	// ToHost additionally pushes virtual-authored Revisions down to the
+	// host cluster, once their owning Configuration exists on both sides.
+	// Defaults to false: Revisions are normally Configuration-managed, so
+	// a user hand-creating one inside the vcluster is the exception, not
+	// the rule.
+	ToHost bool `json:"toHost"`
+
+	// DeleteVirtualOnMissingPhysical deletes a virtual Revision once its
+	// physical counterpart disappears. Defaults to true, today's behavior.
+	// Disabling it annotates the virtual Revision with OrphanedAnnotation
+	// instead, for users who don't want the syncer deleting things they
+	// might still be experimenting with inside the vcluster.
+	DeleteVirtualOnMissingPhysical bool `json:"deleteVirtualOnMissingPhysical"`
+
+	// ParentKinds are the owner kinds IsManaged walks looking for
+	// translate.MarkerLabel, and for which Init registers metadata-only
+	// informers. Defaults to Configuration; Service and Route can be added
+	// for setups that own Revisions more directly.
+	ParentKinds []ParentKind `json:"parentKinds"`
+
+	// PhysicalAuthoritativeKeys are label/annotation keys that Knative's own
+	// controllers write on the physical Revision (routing state,
+	// service/config linkage, traffic tags). The physical value always wins
+	// when both sides disagree on one of these keys. Defaults cover the
+	// well-known serving.knative.dev/* keys; add to this list to pin
+	// ownership of custom keys a user's own controllers write physically.
+	PhysicalAuthoritativeKeys []string `json:"physicalAuthoritativeKeys"`
+
+	// VirtualAuthoritativeKeys are keys that users are expected to set from
+	// inside the vcluster (e.g. their own tags/annotations), which should
+	// not be clobbered by the physical side. Empty by default.
+	VirtualAuthoritativeKeys []string `json:"virtualAuthoritativeKeys"`
+
+	// RecreateOnImmutableDrift enables deleting and recreating a virtual
+	// Revision whose spec has drifted from the physical one, since Revisions
+	// are immutable and an Update would otherwise be silently rejected by the
+	// apiserver. Defaults to true. Disabling it cluster-wide leaves drifted
+	// virtual Revisions as-is (logged, not updated); recreateOptOutAnnotation
+	// opts out a single Revision instead of every one.
+	RecreateOnImmutableDrift bool `json:"recreateOnImmutableDrift"`
+}
+
+// ParentKind identifies an owner kind IsManaged should recognize and Init
+// registers a metadata-only informer for. It mirrors schema.GroupVersionKind,
+// but with lowercase JSON field names, since GroupVersionKind's own
+// Go-cased fields (and its lack of any json tags) aren't meant for decoding
+// the plugin manifest.
+type ParentKind struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+// GroupVersionKind converts a manifest-configured ParentKind into the
+// schema.GroupVersionKind the rest of the syncer works with.
+func (k ParentKind) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: k.Group, Version: k.Version, Kind: k.Kind}
+}
+
+func parentKindFromGVK(gvk schema.GroupVersionKind) ParentKind {
+	return ParentKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind}
+}
+
+// DefaultConfig returns the syncer's behavior prior to Config existing:
+// up-sync only, deleting orphaned virtual Revisions, walking only
+// Configuration owners.
+func DefaultConfig() Config {
+	return Config{
+		FromHost:                       true,
+		ToHost:                         false,
+		DeleteVirtualOnMissingPhysical: true,
+		RecreateOnImmutableDrift:       true,
+		ParentKinds: []ParentKind{
+			parentKindFromGVK(ksvcv1.SchemeGroupVersion.WithKind("Configuration")),
+		},
+
+		// physicalAuthoritativeKeys default to the well-known keys Knative's
+		// own controllers write on the physical Revision.
+		PhysicalAuthoritativeKeys: []string{
+			"serving.knative.dev/service",
+			"serving.knative.dev/configuration",
+			"serving.knative.dev/configurationGeneration",
+			"serving.knative.dev/routingState",
+			"serving.knative.dev/routingStateModified",
+		},
+		VirtualAuthoritativeKeys: []string{},
+	}
+}
+
+// LoadConfig decodes manifest, the plugin manifest's JSON-encoded config
+// block for this syncer, starting from DefaultConfig() so anything the
+// manifest leaves unset keeps its default instead of zeroing out. A nil or
+// empty manifest is valid and just returns DefaultConfig().
+func LoadConfig(manifest []byte) (Config, error) {
+	config := DefaultConfig()
+	if len(manifest) == 0 {
+		return config, nil
+	}
+
+	if err := json.Unmarshal(manifest, &config); err != nil {
+		return Config{}, fmt.Errorf("decode revision syncer config: %w", err)
+	}
+
+	return config, nil
+}
+
+// LoadConfigFromEnv decodes this syncer's config from the CONFIG
+// environment variable, which vcluster-sdk populates with the plugin
+// manifest's config block, falling back to DefaultConfig() if it's unset.
+func LoadConfigFromEnv() (Config, error) {
+	return LoadConfig([]byte(os.Getenv("CONFIG")))
+}
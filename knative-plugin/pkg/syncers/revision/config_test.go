@@ -0,0 +1,46 @@
+package revision
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ksvcv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestDefaultConfigMatchesPriorBehavior(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.True(t, config.FromHost)
+	assert.False(t, config.ToHost)
+	assert.True(t, config.DeleteVirtualOnMissingPhysical)
+	assert.Contains(t, config.ParentKinds, parentKindFromGVK(ksvcv1.SchemeGroupVersion.WithKind("Configuration")))
+	assert.Len(t, config.ParentKinds, 1)
+	assert.Contains(t, config.PhysicalAuthoritativeKeys, "serving.knative.dev/routingState")
+	assert.Empty(t, config.VirtualAuthoritativeKeys)
+	assert.True(t, config.RecreateOnImmutableDrift)
+}
+
+func TestLoadConfigOverridesDefaultsAndKeepsUnsetFields(t *testing.T) {
+	manifest, err := json.Marshal(map[string]interface{}{
+		"toHost": true,
+		"parentKinds": []map[string]string{
+			{"group": ksvcv1.SchemeGroupVersion.Group, "version": ksvcv1.SchemeGroupVersion.Version, "kind": "Service"},
+		},
+	})
+	assert.NoError(t, err)
+
+	config, err := LoadConfig(manifest)
+	assert.NoError(t, err)
+
+	assert.True(t, config.ToHost, "manifest should override the default")
+	assert.True(t, config.FromHost, "manifest didn't set fromHost, so it should keep DefaultConfig()'s value")
+	assert.Equal(t, []ParentKind{{Group: ksvcv1.SchemeGroupVersion.Group, Version: ksvcv1.SchemeGroupVersion.Version, Kind: "Service"}}, config.ParentKinds)
+}
+
+func TestLoadConfigEmptyManifestReturnsDefaults(t *testing.T) {
+	config, err := LoadConfig(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultConfig(), config)
+}
@@ -0,0 +1,100 @@
+package revision
+
+import (
+	plaincontext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/tabwriter"
+
+	"github.com/loft-sh/vcluster-sdk/syncer/context"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	ksvcv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// debugRevisionsPath is exposed on the physical manager's metrics server so
+// operators can reach it with a plain `kubectl exec <syncer-pod> -- curl
+// localhost:<port>/debug/revisions`, or the `vcluster-debug-revisions` CLI
+// wrapper, without attaching a debugger to the syncer.
+const debugRevisionsPath = "/debug/revisions"
+
+// RevisionDebugInfo is what the revisions debug endpoint returns for a
+// single physical Revision: enough to tell an operator why it is, or isn't,
+// visible inside the vcluster.
+type RevisionDebugInfo struct {
+	Physical      types.NamespacedName `json:"physical"`
+	Virtual       types.NamespacedName `json:"virtual"`
+	Configuration types.NamespacedName `json:"configuration,omitempty"`
+	Managed       bool                 `json:"managed"`
+
+	// MatchedOwnerKey is the "Kind/Name" of the owner reference whose
+	// parent carried translate.MarkerLabel. Empty if Managed is false, or
+	// if the Revision matched directly rather than through an owner.
+	MatchedOwnerKey string `json:"matchedOwnerKey,omitempty"`
+}
+
+func (r *revisionSyncer) registerDebugHandler(ctx *context.RegisterContext) error {
+	return ctx.PhysicalManager.AddMetricsExtraHandler(debugRevisionsPath, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		infos, err := r.collectDebugInfo(req.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if req.URL.Query().Get("output") == "table" {
+			writeDebugTable(w, infos)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(infos)
+	}))
+}
+
+func (r *revisionSyncer) collectDebugInfo(ctx plaincontext.Context) ([]RevisionDebugInfo, error) {
+	revisionList := &ksvcv1.RevisionList{}
+	if err := r.physicalClient.List(ctx, revisionList); err != nil {
+		return nil, fmt.Errorf("list physical revisions: %w", err)
+	}
+
+	infos := make([]RevisionDebugInfo, 0, len(revisionList.Items))
+	for i := range revisionList.Items {
+		pRevision := &revisionList.Items[i]
+
+		managed, err := r.NamespacedTranslator.IsManaged(pRevision)
+		matchedOwner := ""
+		if err != nil || !managed {
+			managed, matchedOwner, err = r.isManagedByOwner(pRevision)
+		}
+		if err != nil {
+			klog.Infof("error checking owner for revision %s/%s: %v", pRevision.Namespace, pRevision.Name, err)
+		}
+
+		info := RevisionDebugInfo{
+			Physical:        types.NamespacedName{Namespace: pRevision.Namespace, Name: pRevision.Name},
+			Virtual:         r.PhysicalToVirtual(pRevision),
+			Managed:         managed,
+			MatchedOwnerKey: matchedOwner,
+		}
+
+		for _, configKey := range filterRevisionFromConfiguration(r.physicalNamespace, pRevision) {
+			info.Configuration = types.NamespacedName{Namespace: pRevision.Namespace, Name: configKey}
+			break
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+func writeDebugTable(w http.ResponseWriter, infos []RevisionDebugInfo) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "PHYSICAL\tVIRTUAL\tCONFIGURATION\tMANAGED\tMATCHED OWNER")
+	for _, info := range infos {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\n", info.Physical, info.Virtual, info.Configuration, info.Managed, info.MatchedOwnerKey)
+	}
+	_ = tw.Flush()
+}
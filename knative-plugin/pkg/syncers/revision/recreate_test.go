@@ -0,0 +1,56 @@
+package revision
+
+import (
+	plaincontext "context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ksvcv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// fakeDeleteClient is a client.Client that only implements Get, simulating a
+// virtual Revision that either still exists (still terminating) or has
+// already been removed.
+type fakeDeleteClient struct {
+	client.Client
+
+	exists bool
+	getErr error
+}
+
+func (f *fakeDeleteClient) Get(_ plaincontext.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	if f.getErr != nil {
+		return f.getErr
+	}
+	if f.exists {
+		return nil
+	}
+
+	return kerrors.NewNotFound(ksvcv1.Resource("revisions"), key.Name)
+}
+
+func TestRevisionDeleteFinishedRequeuesWhileStillTerminating(t *testing.T) {
+	finished, err := revisionDeleteFinished(plaincontext.Background(), &fakeDeleteClient{exists: true}, client.ObjectKey{Namespace: "default", Name: "my-rev"})
+
+	assert.NoError(t, err)
+	assert.False(t, finished, "recreateDriftedRevision should requeue instead of creating while the old object is still terminating")
+}
+
+func TestRevisionDeleteFinishedReadyOnceGone(t *testing.T) {
+	finished, err := revisionDeleteFinished(plaincontext.Background(), &fakeDeleteClient{exists: false}, client.ObjectKey{Namespace: "default", Name: "my-rev"})
+
+	assert.NoError(t, err)
+	assert.True(t, finished, "recreateDriftedRevision should proceed to create once the old object is actually gone")
+}
+
+func TestRevisionDeleteFinishedPropagatesUnexpectedErrors(t *testing.T) {
+	getErr := fmt.Errorf("etcd is on fire")
+
+	_, err := revisionDeleteFinished(plaincontext.Background(), &fakeDeleteClient{getErr: getErr}, client.ObjectKey{Namespace: "default", Name: "my-rev"})
+
+	assert.ErrorIs(t, err, getErr)
+}
@@ -0,0 +1,80 @@
+package revision
+
+import (
+	plaincontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ksvcv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// fakeConfigClient is a client.Client that only implements Get, simulating a
+// physical cluster where exactly the Configurations named in exists are
+// present.
+type fakeConfigClient struct {
+	client.Client
+
+	exists map[string]bool
+}
+
+func (f *fakeConfigClient) Get(_ plaincontext.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	if f.exists[key.Name] {
+		return nil
+	}
+
+	return kerrors.NewNotFound(ksvcv1.Resource("configurations"), key.Name)
+}
+
+func revisionWithConfigOwner(configName string) *ksvcv1.Revision {
+	return &ksvcv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-rev",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: ksvcv1.SchemeGroupVersion.String(),
+				Kind:       "Configuration",
+				Name:       configName,
+			}},
+		},
+	}
+}
+
+func TestConfigurationExistsForRevisionTrueWhenOwningConfigurationPresent(t *testing.T) {
+	client := &fakeConfigClient{exists: map[string]bool{"my-config": true}}
+
+	exists := configurationExistsForRevision(plaincontext.Background(), client, "default", revisionWithConfigOwner("my-config"))
+
+	assert.True(t, exists, "twoWaySyncer.SyncDown's host-side check should pass when its owning Configuration exists on the host")
+}
+
+func TestConfigurationExistsForRevisionFalseWhenOwningConfigurationMissing(t *testing.T) {
+	client := &fakeConfigClient{exists: map[string]bool{}}
+
+	exists := configurationExistsForRevision(plaincontext.Background(), client, "default", revisionWithConfigOwner("my-config"))
+
+	assert.False(t, exists, "twoWaySyncer.SyncDown should fall through to base behavior when no owning Configuration exists on the host")
+}
+
+// TestMarkOrphanedIdempotentWhenAlreadyAnnotated passes a nil *context.SyncContext:
+// markOrphaned's already-annotated branch returns before touching ctx at all, so
+// this exercises the idempotency check without needing a fake of the
+// vcluster-sdk SyncContext/event recorder.
+func TestMarkOrphanedIdempotentWhenAlreadyAnnotated(t *testing.T) {
+	r := &revisionSyncer{}
+	vObj := &ksvcv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "my-rev",
+			Annotations: map[string]string{OrphanedAnnotation: "true"},
+		},
+	}
+
+	result, err := r.markOrphaned(nil, vObj)
+
+	assert.NoError(t, err)
+	assert.Zero(t, result, "an already-orphaned Revision should be a no-op, not re-Update the object")
+}
@@ -2,15 +2,22 @@ package revision
 
 import (
 	plaincontext "context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/loft-sh/vcluster-sdk/syncer"
 	"github.com/loft-sh/vcluster-sdk/syncer/context"
 	"github.com/loft-sh/vcluster-sdk/syncer/mapper"
+	"github.com/loft-sh/vcluster-sdk/syncer/patcher"
 	"github.com/loft-sh/vcluster-sdk/syncer/translator"
 	"github.com/loft-sh/vcluster-sdk/translate"
 	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,17 +28,66 @@ import (
 
 const (
 	REGISTER_CONTEXT = "REGISTER_CONTEXT"
+
+	// lastAppliedConfigAnnotation is never reconciled between the physical
+	// and virtual Revision, it is specific to whichever side last ran
+	// kubectl apply.
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	// recreateOptOutAnnotation, set to "true" on the virtual Revision, opts
+	// it out of the delete-and-recreate flow even when
+	// recreateOnImmutableDrift is enabled - e.g. because it's currently
+	// receiving traffic and the churn of a delete/create isn't acceptable.
+	recreateOptOutAnnotation = "plugin.vcluster.loft.sh/no-recreate-on-drift"
+
+	// recreateRequeueInterval is how long recreateDriftedRevision waits
+	// before checking again whether a foreground-deleted virtual Revision
+	// has actually finished terminating, before it's safe to create the
+	// replacement.
+	recreateRequeueInterval = 2 * time.Second
 )
 
-func New(ctx *context.RegisterContext) syncer.Syncer {
-	return &revisionSyncer{
+// excludedMetadataKeys are never copied between the physical and virtual
+// Revision by mergeMetadataSets, on top of the translation markers and
+// resource-version-like keys filtered out by isExcludedMetadataKey.
+var excludedMetadataKeys = map[string]bool{
+	lastAppliedConfigAnnotation: true,
+}
+
+// New constructs the Revision syncer. config controls which sync directions
+// are active and how missing-counterpart objects are handled; pass
+// DefaultConfig() for the syncer's original, up-only behavior.
+func New(ctx *context.RegisterContext, config Config) syncer.Syncer {
+	base := &revisionSyncer{
 		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, "revision", &ksvcv1.Revision{}),
 		physicalClient:       ctx.PhysicalManager.GetClient(),
 		virtualClient:        ctx.VirtualManager.GetClient(),
 		physicalNamespace:    ctx.TargetNamespace,
+		managerCtx:           ctx.Context,
+		config:               config,
+
+		unmanagedOwners: newNegativeOwnerCache(defaultNegativeOwnerCacheSize, defaultNegativeOwnerCacheTTL),
+
+		// recreateOnImmutableDrift mirrors config.RecreateOnImmutableDrift:
+		// Revisions are immutable, so the alternative (attempting an Update)
+		// silently fails whenever spec actually drifts. Operators who'd
+		// rather leave drifted Revisions alone cluster-wide can disable it
+		// via the manifest; recreateOptOutAnnotation opts out a single one.
+		recreateOnImmutableDrift: config.RecreateOnImmutableDrift,
 
 		// nameCache: make(map[types.NamespacedName]types.NamespacedName),
 	}
+
+	// ToHost additionally requires the full bidirectional syncer.Syncer
+	// interface (its SyncDown creates a physical counterpart for a
+	// virtual-authored object), which conflicts with the UpSyncer-only
+	// base type's SyncDown (cleaning up a virtual object whose physical
+	// counterpart disappeared). Only wrap with that behavior when asked.
+	if config.ToHost {
+		return &twoWaySyncer{revisionSyncer: base}
+	}
+
+	return base
 }
 
 type revisionSyncer struct {
@@ -40,6 +96,95 @@ type revisionSyncer struct {
 	physicalClient    client.Client
 	virtualClient     client.Client
 	physicalNamespace string
+
+	// managerCtx is the physical manager's root context, cancelled on
+	// shutdown. isManagedByOwner uses it for its cache Get instead of
+	// context.Background(), so that call actually observes cancellation
+	// instead of blocking forever if the cache is wedged during shutdown.
+	managerCtx plaincontext.Context
+
+	config Config
+
+	// unmanagedOwners short-circuits IsManaged's owner walk for owner UIDs
+	// that were already proven unmanaged recently.
+	unmanagedOwners *negativeOwnerCache
+
+	// recreateOnImmutableDrift gates the delete-and-recreate flow Sync uses
+	// when the virtual Revision's spec has drifted from the physical one.
+	recreateOnImmutableDrift bool
+}
+
+// isExcludedMetadataKey reports whether a label or annotation key must never
+// be reconciled between the physical and virtual Revision: translation
+// markers, last-applied-configuration, and resource-version-like bookkeeping
+// keys are specific to one side and copying them would be meaningless (or
+// actively break translation).
+func isExcludedMetadataKey(key string) bool {
+	if excludedMetadataKeys[key] {
+		return true
+	}
+
+	return strings.HasPrefix(key, translate.MarkerLabel) || strings.HasSuffix(strings.ToLower(key), "resourceversion")
+}
+
+// mergeMetadataSets merges a physical Revision's labels (or annotations)
+// into the virtual Revision's set, keeping whichever side already has a key
+// the other is missing, and falling back to config.PhysicalAuthoritativeKeys
+// / config.VirtualAuthoritativeKeys to pick a winner when both sides set the
+// same key to different values. Physical wins by default, since Revisions
+// are primarily reconciled top-down from the host cluster.
+func (r *revisionSyncer) mergeMetadataSets(virtual, physical map[string]string) map[string]string {
+	merged := map[string]string{}
+	for k, v := range virtual {
+		if isExcludedMetadataKey(k) {
+			continue
+		}
+		merged[k] = v
+	}
+
+	for k, v := range physical {
+		if isExcludedMetadataKey(k) {
+			continue
+		}
+
+		existing, ok := merged[k]
+		if !ok || existing == v || stringSliceContains(r.config.PhysicalAuthoritativeKeys, k) {
+			merged[k] = v
+			continue
+		}
+
+		if stringSliceContains(r.config.VirtualAuthoritativeKeys, k) {
+			continue
+		}
+
+		merged[k] = v
+	}
+
+	if len(merged) == 0 {
+		return nil
+	}
+
+	return merged
+}
+
+func stringSliceContains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parentKindsContainGVK(parentKinds []ParentKind, v schema.GroupVersionKind) bool {
+	for _, parentKind := range parentKinds {
+		if parentKind.GroupVersionKind() == v {
+			return true
+		}
+	}
+
+	return false
 }
 
 var _ syncer.Initializer = &revisionSyncer{}
@@ -59,6 +204,24 @@ func (r *revisionSyncer) Init(ctx *context.RegisterContext) error {
 		},
 	)
 
+	// warm up metadata-only informers for every owner kind IsManaged walks,
+	// so the Get call it makes below is served from cache instead of
+	// fetching the full parent object from the API server on every call.
+	for _, parentKind := range r.config.ParentKinds {
+		gvk := parentKind.GroupVersionKind()
+
+		parentMeta := &metav1.PartialObjectMetadata{}
+		parentMeta.SetGroupVersionKind(gvk)
+
+		if _, err := ctx.PhysicalManager.GetCache().GetInformer(ctx.Context, parentMeta); err != nil {
+			return fmt.Errorf("register metadata informer for %s: %w", gvk, err)
+		}
+	}
+
+	if err := r.registerDebugHandler(ctx); err != nil {
+		return fmt.Errorf("register revisions debug handler: %w", err)
+	}
+
 	return translate.EnsureCRDFromPhysicalCluster(ctx.Context,
 		ctx.PhysicalManager.GetConfig(),
 		ctx.VirtualManager.GetConfig(),
@@ -69,6 +232,10 @@ func (r *revisionSyncer) Init(ctx *context.RegisterContext) error {
 func (r *revisionSyncer) SyncDown(ctx *context.SyncContext, vObj client.Object) (ctrl.Result, error) {
 	ctx.Log.Debugf("SyncDown called for %s:%s", vObj.GetObjectKind().GroupVersionKind().Kind, vObj.GetName())
 
+	if !r.config.DeleteVirtualOnMissingPhysical {
+		return r.markOrphaned(ctx, vObj)
+	}
+
 	ctx.Log.Debugf("Deleting virtual Revision Object %s because physical no longer exists", vObj.GetName())
 	err := ctx.VirtualClient.Delete(ctx.Context, vObj)
 	if err != nil {
@@ -79,41 +246,148 @@ func (r *revisionSyncer) SyncDown(ctx *context.SyncContext, vObj client.Object)
 	return ctrl.Result{}, nil
 }
 
-func (r *revisionSyncer) Sync(ctx *context.SyncContext, pObj, vObj client.Object) (ctrl.Result, error) {
+// markOrphaned annotates vObj instead of deleting it, for users who disabled
+// DeleteVirtualOnMissingPhysical because they don't want the syncer
+// deleting Revisions they might still be experimenting with.
+func (r *revisionSyncer) markOrphaned(ctx *context.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	if vObj.GetAnnotations()[OrphanedAnnotation] == "true" {
+		return ctrl.Result{}, nil
+	}
+
+	newObj := vObj.DeepCopyObject().(client.Object)
+	annotations := newObj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[OrphanedAnnotation] = "true"
+	newObj.SetAnnotations(annotations)
+
+	ctx.Log.Debugf("Marking virtual Revision %s:%s as orphaned because physical no longer exists", newObj.GetNamespace(), newObj.GetName())
+	if err := ctx.VirtualClient.Update(ctx.Context, newObj); err != nil {
+		ctx.Log.Errorf("error marking virtual revision %s:%s as orphaned, %v", newObj.GetNamespace(), newObj.GetName(), err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *revisionSyncer) Sync(ctx *context.SyncContext, pObj, vObj client.Object) (_ ctrl.Result, retErr error) {
 	ctx.Log.Debugf("Sync called for Revision %s : %s", pObj.GetName(), vObj.GetName())
 
+	if !r.config.FromHost {
+		return ctrl.Result{}, nil
+	}
+
 	pRevision := pObj.(*ksvcv1.Revision)
 	vRevision := vObj.(*ksvcv1.Revision)
 
-	// since revisions are immutable and are created by config
-	// we are never interested in sync down events for revisions
+	// Revisions are immutable after creation, so spec drift can only be
+	// resolved by deleting and recreating the virtual object - an Update
+	// would be silently rejected by the apiserver for the immutable fields.
+	// This is destructive (new UID, a window with no virtual Revision), so
+	// it's gated behind recreateOnImmutableDrift and a per-object opt-out.
 	if !equality.Semantic.DeepEqual(vRevision.Spec, pRevision.Spec) {
-		newRevision := vRevision.DeepCopy()
-		newRevision.Spec = pRevision.Spec
-		ctx.Log.Debugf("Update virtual revision %s:%s, because spec is out of sync", vRevision.Namespace, vRevision.Name)
-		err := ctx.VirtualClient.Update(ctx.Context, newRevision)
-		if err != nil {
-			ctx.Log.Errorf("Error updating virtual kconfig spec for %s:%s, %v", vRevision.Namespace, vRevision.Name, err)
-			return ctrl.Result{}, err
+		if r.recreateOnImmutableDrift && vRevision.Annotations[recreateOptOutAnnotation] != "true" {
+			return r.recreateDriftedRevision(ctx, pRevision, vRevision)
 		}
 
-		return ctrl.Result{}, nil
+		ctx.Log.Infof("virtual revision %s:%s spec differs from physical but recreate-on-drift is disabled for it; "+
+			"skipping, since Revisions are immutable and an Update would be rejected", vRevision.Namespace, vRevision.Name)
 	}
 
-	if !equality.Semantic.DeepEqual(vRevision.Status, pRevision.Status) {
-		newRevision := vRevision.DeepCopy()
-		newRevision.Status = pRevision.Status
-		ctx.Log.Errorf("Update virtual revision %s:%s, because status is out of sync", vRevision.Namespace, vRevision.Name)
-		err := ctx.VirtualClient.Status().Update(ctx.Context, newRevision)
-		if err != nil {
-			ctx.Log.Errorf("Error updating virtual kconfig status for %s:%s, %v", vRevision.Namespace, vRevision.Name, err)
-			return ctrl.Result{}, err
+	// the patcher snapshots vRevision as it is right now (with an optimistic
+	// lock on its resource version) so that, however we mutate it below, the
+	// deferred Patch only sends the fields that actually changed instead of
+	// a full Update/Status().Update() of the whole object.
+	patch, err := patcher.NewSyncerPatcher(ctx, pRevision, vRevision)
+	if err != nil {
+		ctx.Log.Errorf("error creating patcher for revision %s:%s, %v", vRevision.Namespace, vRevision.Name, err)
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		if err := patch.Patch(ctx.Context, pRevision, vRevision); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
 		}
+	}()
+
+	if !equality.Semantic.DeepEqual(vRevision.Status, pRevision.Status) {
+		ctx.Log.Debugf("Updating virtual revision %s:%s, because status is out of sync", vRevision.Namespace, vRevision.Name)
+		vRevision.Status = pRevision.Status
+	}
+
+	mergedLabels := r.mergeMetadataSets(vRevision.Labels, pRevision.Labels)
+	mergedAnnotations := r.mergeMetadataSets(vRevision.Annotations, pRevision.Annotations)
+	if !equality.Semantic.DeepEqual(vRevision.Labels, mergedLabels) || !equality.Semantic.DeepEqual(vRevision.Annotations, mergedAnnotations) {
+		ctx.Log.Debugf("Updating virtual revision %s:%s, because labels/annotations are out of sync", vRevision.Namespace, vRevision.Name)
+		vRevision.Labels = mergedLabels
+		vRevision.Annotations = mergedAnnotations
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// recreateDriftedRevision deletes the virtual Revision and recreates it from
+// the physical spec. Kubernetes always assigns a fresh UID and
+// ResourceVersion on create - those can't be forced back onto the new
+// object - so this trades a brief window with no virtual Revision for
+// actually reflecting the physical spec, instead of an Update that the
+// apiserver would silently reject.
+//
+// Anything inside the vcluster that refers to the Revision by name (a
+// Route, a Service's latestCreatedRevisionName, a user's own watch)
+// recovers on its next reconcile, since the name is preserved. Anything
+// that pins the old UID specifically - an ownerReference, a controller
+// caching the UID - does not follow the recreate and needs its own
+// reconcile to pick up the new one; that's an inherent consequence of
+// Revisions being immutable, not something this syncer can paper over.
+func (r *revisionSyncer) recreateDriftedRevision(ctx *context.SyncContext, pRevision, vRevision *ksvcv1.Revision) (ctrl.Result, error) {
+	ctx.Log.Infof("recreating virtual revision %s:%s because its spec drifted from the immutable physical revision", vRevision.Namespace, vRevision.Name)
+
+	r.NamespacedTranslator.EventRecorder().Eventf(vRevision, "Warning", "RecreateImmutableDrift",
+		"Deleting and recreating Revision because its spec drifted from the physical Revision, which is immutable")
+
+	foreground := metav1.DeletePropagationForeground
+	err := ctx.VirtualClient.Delete(ctx.Context, vRevision, &client.DeleteOptions{PropagationPolicy: &foreground})
+	if err != nil && !kerrors.IsNotFound(err) {
+		ctx.Log.Errorf("error deleting virtual revision %s:%s for recreate, %v", vRevision.Namespace, vRevision.Name, err)
+		return ctrl.Result{}, err
+	}
+
+	// Foreground deletion only sets a deletionTimestamp and returns while
+	// the object finalizes; creating the replacement before it's actually
+	// gone would hit AlreadyExists on essentially every first pass. Confirm
+	// it's gone first, and requeue to check again otherwise - the virtual
+	// object disappearing will also trigger the framework's normal SyncUp
+	// for the still-existing physical one, so requeuing here is a backstop
+	// rather than the only path to recovery.
+	finished, err := revisionDeleteFinished(ctx.Context, ctx.VirtualClient, client.ObjectKey{Namespace: vRevision.Namespace, Name: vRevision.Name})
+	if err != nil {
+		ctx.Log.Errorf("error confirming virtual revision %s:%s was deleted for recreate, %v", vRevision.Namespace, vRevision.Name, err)
+		return ctrl.Result{}, err
+	}
+	if !finished {
+		ctx.Log.Debugf("virtual revision %s:%s still terminating after recreate delete, requeueing", vRevision.Namespace, vRevision.Name)
+		return ctrl.Result{RequeueAfter: recreateRequeueInterval}, nil
+	}
+
+	return r.SyncUpCreate(ctx, pRevision.DeepCopyObject().(client.Object))
+}
+
+// revisionDeleteFinished reports whether the virtual Revision at key has
+// actually finished terminating after a foreground delete.
+func revisionDeleteFinished(ctx plaincontext.Context, virtualClient client.Client, key client.ObjectKey) (bool, error) {
+	err := virtualClient.Get(ctx, key, &ksvcv1.Revision{})
+	if err == nil {
+		return false, nil
+	}
+	if kerrors.IsNotFound(err) {
+		return true, nil
+	}
+
+	return false, err
+}
+
 func (r *revisionSyncer) SyncUp(ctx *context.SyncContext, pObj client.Object) (ctrl.Result, error) {
 	ctx.Log.Debugf("SyncUp called for revision ", pObj.GetName())
 	newObj := pObj.DeepCopyObject().(client.Object)
@@ -125,10 +399,24 @@ func (r *revisionSyncer) SyncUpCreate(ctx *context.SyncContext, pObj client.Obje
 	ctx.Log.Debugf("SyncUpCreate called for %s:%s", pObj.GetName(), pObj.GetNamespace())
 	ctx.Log.Debugf("reverse name should be ", r.PhysicalToVirtual(pObj))
 
+	if !r.config.FromHost {
+		return ctrl.Result{}, nil
+	}
+
 	// TODO: find relevant parent of object
 	pObj = r.ReverseTranslateMetadata(ctx, pObj, nil)
 
-	err := ctx.VirtualClient.Create(ctx.Context, pObj)
+	// bring the physical Revision's labels/annotations up with it, minus
+	// the excluded set, so newly discovered revisions start out with the
+	// same Knative-managed metadata they would have picked up via Sync.
+	metaAccessor, err := meta.Accessor(pObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	metaAccessor.SetLabels(r.mergeMetadataSets(nil, metaAccessor.GetLabels()))
+	metaAccessor.SetAnnotations(r.mergeMetadataSets(nil, metaAccessor.GetAnnotations()))
+
+	err = ctx.VirtualClient.Create(ctx.Context, pObj)
 	if err != nil {
 		ctx.Log.Errorf("error creating virtual revision object %s/%s, %v", pObj.GetNamespace(), pObj.GetName(), err)
 		r.NamespacedTranslator.EventRecorder().Eventf(pObj, "Warning", "SyncError", "Error syncing to virtual cluster: %v", err)
@@ -144,52 +432,135 @@ func (r *revisionSyncer) IsManaged(obj client.Object) (bool, error) {
 		return managed, err
 	}
 
-	// else try to check if this revision belongs to a configuration
-	// which is managed by a vcluster
+	managedByOwner, _, err := r.isManagedByOwner(obj)
+	return managedByOwner, err
+}
 
+// isManagedByOwner walks obj's owner references looking for one whose parent
+// carries translate.MarkerLabel, i.e. the Configuration/Service/Route it
+// belongs to is itself managed by a vcluster. It also reports the "Kind/Name"
+// of the owner reference that matched, which IsManaged discards but the
+// `vcluster debug revisions` endpoint surfaces to operators.
+func (r *revisionSyncer) isManagedByOwner(obj client.Object) (bool, string, error) {
 	metaAccessor, err := meta.Accessor(obj)
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 
 	owners := metaAccessor.GetOwnerReferences()
 
 	for _, owner := range owners {
-		parent, err := r.physicalClient.Scheme().New(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
-		if err != nil {
-			klog.Errorf("error converting %s/%s to a runtime object %v", owner.Kind, owner.APIVersion)
+		if r.unmanagedOwners.Known(owner.UID) {
+			continue
+		}
+
+		ownerGVK := schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind)
+		if !parentKindsContainGVK(r.config.ParentKinds, ownerGVK) {
+			// not a kind we walk at all (e.g. the Service/Route refs on a
+			// Revision when only Configuration is configured) - this will
+			// never change for a given owner reference, so cache it too,
+			// otherwise every reconcile re-evaluates parentKindsContainGVK
+			// for owners we already know we'll never look up.
+			r.unmanagedOwners.Add(owner.UID)
 			continue
 		}
 
-		err = r.physicalClient.Get(plaincontext.Background(), client.ObjectKey{
+		parentMeta := &metav1.PartialObjectMetadata{}
+		parentMeta.SetGroupVersionKind(ownerGVK)
+
+		// fetch only the owner's metadata (labels/annotations/owner refs),
+		// served from the metadata-only informer cache registered in Init,
+		// instead of pulling the full Configuration/Service/Route object
+		// through a live API call on every reconcile.
+		err = r.physicalClient.Get(r.managerCtx, client.ObjectKey{
 			Name:      owner.Name,
 			Namespace: metaAccessor.GetNamespace(),
-		}, parent.(client.Object))
+		}, parentMeta)
 		if err != nil {
 			klog.Infof("cannot get physical object %s %s/%s: %v",
-				parent.GetObjectKind().GroupVersionKind().Kind,
+				owner.Kind,
 				owner.Name,
 				metaAccessor.GetNamespace(),
 				err)
 			continue
 		}
 
-		parentMetaAccessor, err := meta.Accessor(parent)
-		if err != nil {
-			klog.Infof("error checking parent meta accessor object %s %s/%s: %v",
-				parent.GetObjectKind().GroupVersionKind().Kind,
-				owner.Name,
-				metaAccessor.GetNamespace(),
-				err)
+		if v, ok := parentMeta.GetLabels()[translate.MarkerLabel]; ok {
+			if v == translate.Suffix {
+				return true, fmt.Sprintf("%s/%s", owner.Kind, owner.Name), nil
+			}
+		}
+
+		r.unmanagedOwners.Add(owner.UID)
+	}
+
+	return false, "", nil
+}
+
+// twoWaySyncer wraps revisionSyncer with the full bidirectional syncer.Syncer
+// interface, used instead of the base type when Config.ToHost is enabled.
+// Its SyncDown creates a physical Revision for a virtual-authored one,
+// which is a different meaning of "SyncDown" than the UpSyncer base type
+// uses (deleting a virtual Revision whose physical counterpart vanished),
+// so the two can't live on the same method of the same type.
+type twoWaySyncer struct {
+	*revisionSyncer
+}
+
+var _ syncer.Syncer = &twoWaySyncer{}
+
+// SyncDown is called for a virtual Revision with no physical counterpart.
+// If its owning Configuration exists on both sides, push it down by
+// creating the physical Revision; otherwise fall back to the base
+// behavior (delete or mark orphaned, per DeleteVirtualOnMissingPhysical).
+func (s *twoWaySyncer) SyncDown(ctx *context.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	vRevision := vObj.(*ksvcv1.Revision)
+
+	virtualConfigExists := false
+	for _, owner := range vRevision.OwnerReferences {
+		if owner.Kind != "Configuration" {
 			continue
 		}
+		if err := ctx.VirtualClient.Get(ctx.Context, client.ObjectKey{Namespace: vRevision.Namespace, Name: owner.Name}, &ksvcv1.Configuration{}); err == nil {
+			virtualConfigExists = true
+			break
+		}
+	}
 
-		if v, ok := parentMetaAccessor.GetLabels()[translate.MarkerLabel]; ok {
-			if v == translate.Suffix {
-				return true, nil
-			}
+	if virtualConfigExists && configurationExistsForRevision(ctx.Context, s.physicalClient, s.physicalNamespace, vRevision) {
+		return s.syncDownCreate(ctx, vRevision)
+	}
+
+	return s.revisionSyncer.SyncDown(ctx, vObj)
+}
+
+// configurationExistsForRevision reports whether any of vRevision's owning
+// Configuration names (there's normally exactly one) exists on the physical
+// side. twoWaySyncer.SyncDown uses this to decide whether to push a
+// virtual-authored Revision down, instead of falling back to base SyncDown.
+func configurationExistsForRevision(ctx plaincontext.Context, physicalClient client.Client, physicalNamespace string, vRevision *ksvcv1.Revision) bool {
+	for _, configName := range filterRevisionFromConfiguration(physicalNamespace, vRevision) {
+		pConfig := &ksvcv1.Configuration{}
+		if err := physicalClient.Get(ctx, client.ObjectKey{Namespace: physicalNamespace, Name: configName}, pConfig); err == nil {
+			return true
 		}
 	}
 
-	return false, nil
+	return false
+}
+
+// syncDownCreate creates the physical Revision for a virtual-authored one.
+func (s *twoWaySyncer) syncDownCreate(ctx *context.SyncContext, vRevision *ksvcv1.Revision) (ctrl.Result, error) {
+	pRevision := s.TranslateMetadata(ctx, vRevision).(*ksvcv1.Revision)
+	pRevision.Spec = vRevision.Spec
+
+	err := s.physicalClient.Create(ctx.Context, pRevision)
+	if err != nil {
+		ctx.Log.Errorf("error creating physical revision %s/%s for virtual-authored revision %s/%s, %v",
+			pRevision.Namespace, pRevision.Name, vRevision.Namespace, vRevision.Name, err)
+		s.NamespacedTranslator.EventRecorder().Eventf(vRevision, "Warning", "SyncDownError", "Error syncing to host cluster: %v", err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
 }
@@ -0,0 +1,53 @@
+package revision
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster-sdk/translate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeMetadataSets(t *testing.T) {
+	r := &revisionSyncer{
+		config: Config{
+			PhysicalAuthoritativeKeys: []string{"serving.knative.dev/routingState"},
+			VirtualAuthoritativeKeys:  []string{"my.company.io/tag"},
+		},
+	}
+
+	virtual := map[string]string{
+		"serving.knative.dev/routingState": "stale",
+		"my.company.io/tag":                "blue",
+		"virtual-only":                     "v",
+		lastAppliedConfigAnnotation:        "should-never-copy",
+	}
+	physical := map[string]string{
+		"serving.knative.dev/routingState": "active",
+		"my.company.io/tag":                "green",
+		"physical-only":                    "p",
+		translate.MarkerLabel:              "suffix",
+	}
+
+	merged := r.mergeMetadataSets(virtual, physical)
+
+	assert.Equal(t, "active", merged["serving.knative.dev/routingState"], "physical-authoritative key should take the physical value")
+	assert.Equal(t, "blue", merged["my.company.io/tag"], "virtual-authoritative key should keep the virtual value")
+	assert.Equal(t, "v", merged["virtual-only"])
+	assert.Equal(t, "p", merged["physical-only"])
+	assert.NotContains(t, merged, lastAppliedConfigAnnotation)
+	assert.NotContains(t, merged, translate.MarkerLabel)
+}
+
+func TestMergeMetadataSetsNoConflict(t *testing.T) {
+	r := &revisionSyncer{}
+
+	merged := r.mergeMetadataSets(map[string]string{"a": "1"}, map[string]string{"a": "1", "b": "2"})
+
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, merged)
+}
+
+func TestMergeMetadataSetsEmpty(t *testing.T) {
+	r := &revisionSyncer{}
+
+	assert.Nil(t, r.mergeMetadataSets(nil, nil))
+}